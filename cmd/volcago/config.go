@@ -0,0 +1,26 @@
+package main
+
+import (
+	"flag"
+	"log"
+
+	"github.com/fujiwara-manabu-0516/volcago/generator"
+)
+
+// runWithConfig implements `volcago -config volcago.yaml`, generating every
+// target declared in the config file instead of a single struct passed as
+// a positional argument.
+func runWithConfig(configPath, appVersion string) {
+	cfg, err := generator.LoadConfig(configPath)
+	if err != nil {
+		log.Fatalf("failed to load %s: %+v", configPath, err)
+	}
+
+	if err := generator.GenerateFromConfig(cfg, appVersion); err != nil {
+		log.Fatalf("failed to generate from %s: %+v", configPath, err)
+	}
+}
+
+// configFlag is registered alongside the existing positional-argument mode;
+// when set, it takes precedence and the struct name argument is ignored.
+var configFlag = flag.String("config", "", "path to a volcago.yaml config file")
@@ -0,0 +1,54 @@
+// Command volcago generates a Firestore repository for a struct, either from
+// a single //go:generate directive (`volcago <StructName>`) or for every
+// target declared in a volcago.yaml config file (`volcago -config volcago.yaml`).
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	"github.com/fujiwara-manabu-0516/volcago/generator"
+	"github.com/go-generalize/go-easyparser"
+)
+
+const appVersion = "dev"
+
+func main() {
+	flag.Parse()
+
+	if *configFlag != "" {
+		runWithConfig(*configFlag, appVersion)
+		return
+	}
+
+	args := flag.Args()
+	if len(args) != 1 {
+		log.Fatal("usage: volcago <StructName> | volcago -config volcago.yaml")
+	}
+
+	runLegacy(args[0], appVersion)
+}
+
+// runLegacy implements the original `volcago <StructName>` mode, generating
+// a repository for a struct found in the current directory's package.
+func runLegacy(structName, appVersion string) {
+	dir, err := os.Getwd()
+	if err != nil {
+		log.Fatalf("failed to get working directory: %+v", err)
+	}
+
+	pkg, err := easyparser.ParseDir(dir)
+	if err != nil {
+		log.Fatalf("failed to parse %s: %+v", dir, err)
+	}
+
+	obj, ok := pkg.Structs[structName]
+	if !ok {
+		log.Fatalf("struct %s not found in %s", structName, dir)
+	}
+
+	if err := generator.GenerateStruct(obj, structName, appVersion, generator.GenerateOption{OutputDir: dir}); err != nil {
+		log.Fatalf("failed to generate %s: %+v", structName, err)
+	}
+}
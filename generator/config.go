@@ -0,0 +1,152 @@
+package generator
+
+import (
+	"os"
+	"strings"
+
+	"github.com/go-generalize/go-easyparser"
+	"github.com/go-generalize/go-easyparser/types"
+	"golang.org/x/xerrors"
+	"gopkg.in/yaml.v2"
+)
+
+// Config represents the contents of a volcago.yaml file, which declares every
+// generation target for a repository in one place instead of relying on
+// scattered //go:generate directives.
+type Config struct {
+	Targets []*ConfigTarget `yaml:"targets"`
+}
+
+// ConfigTarget describes a single struct to generate a repository for.
+type ConfigTarget struct {
+	// Struct is the path to the package containing the target struct, e.g. "./model".
+	Struct string `yaml:"struct"`
+	// Name is the name of the target struct within Struct.
+	Name string `yaml:"name"`
+
+	OutputDir      string `yaml:"outputDir"`
+	PackageName    string `yaml:"packageName"`
+	CollectionName string `yaml:"collectionName"`
+	Subcollection  bool   `yaml:"subcollection"`
+
+	MockGenPath    string `yaml:"mockGenPath"`
+	MockOutputPath string `yaml:"mockOutputPath"`
+
+	DisableMetaFieldsDetection bool `yaml:"disableMetaFieldsDetection"`
+
+	// EnableBatch turns on generation of the batch/transaction builder (see
+	// batch.go.tmpl) for this target.
+	EnableBatch bool `yaml:"enableBatch"`
+	// EnableGraphQL turns on generation of a GraphQL schema and resolver
+	// stubs (see graphql.tmpl) for this target, equivalent to tagging the
+	// struct's key field `gqlgen:"expose"`.
+	EnableGraphQL bool `yaml:"enableGraphQL"`
+
+	// TemplateOverrides maps template names (e.g. "gen.go.tmpl") to a path
+	// of a user-supplied template that should be used in its place.
+	TemplateOverrides map[string]string `yaml:"templateOverrides"`
+
+	// TagOverrides lets a field be annotated (e.g. with `unique`) without
+	// editing the struct definition, keyed by the field's Go name.
+	TagOverrides map[string]string `yaml:"tagOverrides"`
+}
+
+// LoadConfig reads and parses a volcago.yaml config file.
+func LoadConfig(path string) (*Config, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, xerrors.Errorf("failed to open %s: %w", path, err)
+	}
+	defer f.Close()
+
+	cfg := &Config{}
+	if err := yaml.NewDecoder(f).Decode(cfg); err != nil {
+		return nil, xerrors.Errorf("failed to decode %s: %w", path, err)
+	}
+
+	return cfg, nil
+}
+
+// GenerateFromConfig walks every target declared in cfg, loads its package
+// with go-easyparser, resolves the named struct and drives a structGenerator
+// for it. appVersion is forwarded to each generator as-is.
+func GenerateFromConfig(cfg *Config, appVersion string) error {
+	for _, target := range cfg.Targets {
+		if err := generateTarget(target, appVersion); err != nil {
+			return xerrors.Errorf("failed to generate %s: %w", target.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func generateTarget(target *ConfigTarget, appVersion string) error {
+	pkg, err := easyparser.ParseDir(target.Struct)
+	if err != nil {
+		return xerrors.Errorf("failed to parse %s: %w", target.Struct, err)
+	}
+
+	obj, ok := pkg.Structs[target.Name]
+	if !ok {
+		return xerrors.Errorf("struct %s not found in %s", target.Name, target.Struct)
+	}
+
+	applyTagOverrides(obj, target.TagOverrides)
+
+	opt := GenerateOption{
+		OutputDir:                  target.OutputDir,
+		PackageName:                target.PackageName,
+		CollectionName:             target.CollectionName,
+		Subcollection:              target.Subcollection,
+		MockGenPath:                target.MockGenPath,
+		MockOutputPath:             target.MockOutputPath,
+		DisableMetaFieldsDetection: target.DisableMetaFieldsDetection,
+		EnableBatch:                target.EnableBatch,
+		EnableGraphQL:              target.EnableGraphQL,
+		TemplateOverrides:          target.TemplateOverrides,
+	}
+
+	return GenerateStruct(obj, target.Name, appVersion, opt)
+}
+
+// GenerateStruct drives a structGenerator for a single already-resolved
+// struct. It is the shared entry point used both by GenerateFromConfig and
+// by cmd/volcago's legacy `volcago <StructName>` mode.
+func GenerateStruct(obj *types.Object, structName, appVersion string, opt GenerateOption) error {
+	g, err := newStructGenerator(obj, structName, appVersion, opt)
+	if err != nil {
+		return xerrors.Errorf("failed to initialize generator: %w", err)
+	}
+
+	if err := g.parseType(); err != nil {
+		return xerrors.Errorf("failed to parse type: %w", err)
+	}
+
+	if err := g.generate(); err != nil {
+		return xerrors.Errorf("failed to generate: %w", err)
+	}
+
+	return nil
+}
+
+// applyTagOverrides rewrites the raw struct tag of fields named in overrides,
+// letting a config opt a field into features (e.g. `unique`) without editing
+// the source model. RawTag holds the bare tag content (no surrounding
+// backticks, see structGenerator.go's structtag.Parse(e.RawTag)), so extra is
+// simply appended.
+func applyTagOverrides(obj *types.Object, overrides map[string]string) {
+	if len(overrides) == 0 {
+		return
+	}
+
+	for name, extra := range overrides {
+		e, ok := obj.Entries[name]
+		if !ok {
+			continue
+		}
+
+		e.RawTag = strings.TrimSpace(e.RawTag + " " + extra)
+
+		obj.Entries[name] = e
+	}
+}
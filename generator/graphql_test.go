@@ -0,0 +1,72 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-generalize/go-easyparser"
+)
+
+// TestGraphQLGeneration_NestedFieldNamesAreValid drives generation for the
+// ExposedNested fixture, which has a nested struct, a slice field, and a
+// meta-Time field, and checks the emitted .graphql schema never contains a
+// dotted field name (invalid GraphQL SDL) and that the list/Time branches of
+// gqlType render as expected.
+func TestGraphQLGeneration_NestedFieldNamesAreValid(t *testing.T) {
+	pkg, err := easyparser.ParseDir("./testfiles/auto/graphql")
+	if err != nil {
+		t.Fatalf("failed to parse testfiles/auto/graphql: %+v", err)
+	}
+
+	obj, ok := pkg.Structs["ExposedNested"]
+	if !ok {
+		t.Fatal("ExposedNested struct not found in testfiles/auto/graphql")
+	}
+
+	outDir := t.TempDir()
+
+	g, err := newStructGenerator(obj, "ExposedNested", "test", GenerateOption{
+		OutputDir: outDir,
+		// ExposedNested only carries CreatedAt (to exercise the meta-Time
+		// branch of gqlType), not the full meta field set, so skip the
+		// all-or-nothing meta field detection.
+		DisableMetaFieldsDetection: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to initialize generator: %+v", err)
+	}
+
+	if err := g.parseType(); err != nil {
+		t.Fatalf("failed to parse type: %+v", err)
+	}
+
+	if !g.param.GraphQLEnabled {
+		t.Fatal("expected gqlgen:\"expose\" to set GraphQLEnabled")
+	}
+
+	if err := g.generate(); err != nil {
+		t.Fatalf("failed to generate: %+v", err)
+	}
+
+	content, err := os.ReadFile(filepath.Join(outDir, "exposed_nested.graphql"))
+	if err != nil {
+		t.Fatalf("failed to read exposed_nested.graphql: %+v", err)
+	}
+	schema := string(content)
+
+	if strings.Contains(schema, "Address.City") {
+		t.Errorf("schema contains a dotted field identifier, invalid GraphQL SDL:\n%s", schema)
+	}
+
+	for _, want := range []string{
+		"addressCity: String!", // nested field, flattened and capitalized
+		"tags: [String!]!",     // slice field
+		"createdAt: Time!",     // meta field, forced to Time
+	} {
+		if !strings.Contains(schema, want) {
+			t.Errorf("schema does not contain %q:\n%s", want, schema)
+		}
+	}
+}
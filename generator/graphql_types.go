@@ -0,0 +1,98 @@
+package generator
+
+import (
+	"strings"
+	"text/template"
+
+	"github.com/iancoleman/strcase"
+)
+
+// metaFieldGraphQLTypes lists the meta fields (see hasMetaFields) that are
+// surfaced as the scalar GraphQL Time type rather than being derived from
+// their Go type.
+var metaFieldGraphQLTypes = map[string]string{
+	"CreatedAt": "Time",
+	"UpdatedAt": "Time",
+	"DeletedAt": "Time",
+}
+
+// graphQLFuncMap returns the extra template functions used by graphql.tmpl
+// and graphql_resolver.go.tmpl, layered on top of g.getFuncMap().
+func graphQLFuncMap() template.FuncMap {
+	return template.FuncMap{
+		"gqlType":      gqlType,
+		"gqlFieldName": gqlFieldName,
+		"isNested":     isNestedField,
+	}
+}
+
+// gqlFieldName converts a FieldInfo.Field path into a single valid GraphQL
+// field name. Field is dot-joined for fields nested inside a struct or an
+// embedded type (e.g. "Address.City", or the meta fields embedded via
+// meta.AAAMeta in LockMeta2); GraphQL identifiers can't contain ".", so each
+// segment after the first is capitalized and the parts are joined.
+func gqlFieldName(field string) string {
+	parts := strings.Split(field, ".")
+	for i, p := range parts {
+		if i == 0 {
+			parts[i] = strcase.ToLowerCamel(p)
+		} else {
+			parts[i] = strcase.ToCamel(p)
+		}
+	}
+
+	return strings.Join(parts, "")
+}
+
+// isNestedField reports whether field addresses a field nested inside a
+// struct or embedded type, i.e. is not a direct top-level field.
+func isNestedField(field string) bool {
+	return strings.Contains(field, ".")
+}
+
+// gqlType maps a FieldInfo.FieldType (as produced by getGoTypeFromEPTypes)
+// to the GraphQL type it should be exposed as. field is the FieldInfo.Field
+// path; a top-level meta field name (e.g. "CreatedAt") surfaces as Time
+// regardless of its underlying Go representation, while a dotted path (e.g.
+// "AAAMeta.CreatedAt") is mapped from its Go type alone.
+func gqlType(field, fieldType string) string {
+	nullable := strings.HasPrefix(fieldType, "*")
+	fieldType = strings.TrimPrefix(fieldType, "*")
+
+	list := strings.HasPrefix(fieldType, "[]")
+	fieldType = strings.TrimPrefix(fieldType, "[]")
+
+	var gt string
+	if mt, ok := metaFieldGraphQLTypes[field]; ok {
+		gt = mt
+	} else {
+		gt = scalarGraphQLType(fieldType)
+	}
+
+	if list {
+		gt = "[" + gt + "!]"
+	}
+
+	if !nullable {
+		gt += "!"
+	}
+
+	return gt
+}
+
+func scalarGraphQLType(fieldType string) string {
+	switch fieldType {
+	case typeString:
+		return "String"
+	case typeInt64, "int", "int32":
+		return "Int"
+	case "float64", "float32":
+		return "Float"
+	case "bool":
+		return "Boolean"
+	case "time.Time":
+		return "Time"
+	default:
+		return fieldType
+	}
+}
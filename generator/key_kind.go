@@ -0,0 +1,57 @@
+package generator
+
+import (
+	"github.com/go-generalize/go-easyparser/types"
+)
+
+const typeInt64 = "int64"
+
+// keyKind classifies the underlying primitive kind backing a document key
+// field. Firestore document IDs are always strings on the wire, so every
+// kind needs its own string<->Go conversion at generation time.
+type keyKind int
+
+const (
+	keyKindInvalid keyKind = iota
+	keyKindString
+	keyKindInt64
+)
+
+func (k keyKind) String() string {
+	switch k {
+	case keyKindString:
+		return typeString
+	case keyKindInt64:
+		return typeInt64
+	default:
+		return ""
+	}
+}
+
+// resolveKeyKind determines which primitive Firestore key conversion
+// applies to a field of type t (rendered as typeName by
+// getGoTypeFromEPTypes). It accepts "string"/"int64" directly, as well as
+// any named type whose underlying type is one of those, e.g.
+// `type UserID string` or `type ExternalID int64`.
+func resolveKeyKind(t types.Type, typeName string) keyKind {
+	switch typeName {
+	case typeString:
+		return keyKindString
+	case typeInt64:
+		return keyKindInt64
+	}
+
+	named, ok := t.(*types.Named)
+	if !ok {
+		return keyKindInvalid
+	}
+
+	switch getGoTypeFromEPTypes(named.Underlying) {
+	case typeString:
+		return keyKindString
+	case typeInt64:
+		return keyKindInt64
+	default:
+		return keyKindInvalid
+	}
+}
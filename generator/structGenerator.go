@@ -2,6 +2,7 @@ package generator
 
 import (
 	"log"
+	"os"
 	"path/filepath"
 	"sort"
 	"strings"
@@ -60,6 +61,8 @@ func newStructGenerator(typ *types.Object, structName, appVersion string, opt Ge
 	g.param.GeneratedFileName = g.param.FileName + "_gen"
 	g.param.MetaFieldsEnabled = hasMetaFields
 	g.param.IsSubCollection = g.opt.Subcollection
+	g.param.EnableBatch = g.opt.EnableBatch
+	g.param.GraphQLEnabled = g.opt.EnableGraphQL
 
 	g.param.AppVersion = appVersion
 	g.param.RepositoryInterfaceName = structName + "Repository"
@@ -254,6 +257,12 @@ func (g *structGenerator) parseTypeImpl(rawKey, firestoreKey string, obj *types.
 			fieldFirestoreKey = strings.Join(sliceutil.RemoveEmpty([]string{fieldFirestoreKey, fsTag.Name}), ".")
 		}
 
+		if rawKey == "" {
+			if gqlTag, err := tags.Get("gqlgen"); err == nil && gqlTag.Name == "expose" {
+				g.param.GraphQLEnabled = true
+			}
+		}
+
 		if isNestedStruct(e.Type) {
 			isNullable := false
 			var o *types.Object
@@ -339,10 +348,18 @@ func (g *structGenerator) parseTypeImpl(rawKey, firestoreKey string, obj *types.
 			continue
 		}
 
+		kind := resolveKeyKind(e.Type, typeName)
+		if kind == keyKindInvalid {
+			return xerrors.Errorf("%s: supported key types are string, int64, or a named type based on one of them, got %s", pos, typeName)
+		}
+
 		switch tag.Value() {
 		case "":
 			// ok
 		case "auto":
+			if kind != keyKindString {
+				return xerrors.Errorf(`%s: firestore_key:"auto" is only supported for string-kind key fields, got %s`, pos, typeName)
+			}
 			g.param.AutomaticGeneration = true
 		default:
 			return xerrors.Errorf(`%s: The contents of the firestore_key tag should be "" or "auto"`, pos)
@@ -355,10 +372,8 @@ func (g *structGenerator) parseTypeImpl(rawKey, firestoreKey string, obj *types.
 
 		g.param.KeyFieldName = e.RawName
 		g.param.KeyFieldType = typeName
-
-		if g.param.KeyFieldType != typeString {
-			return xerrors.New("supported key types are string")
-		}
+		g.param.KeyFieldKind = kind.String()
+		g.param.KeyFieldIsNamed = kind != keyKindInvalid && typeName != kind.String()
 
 		g.param.KeyValueName = strcase.ToLowerCamel(e.RawName)
 
@@ -379,9 +394,21 @@ func (g *structGenerator) generate() error {
 	templates := template.Must(
 		template.New("").
 			Funcs(g.getFuncMap()).
+			Funcs(graphQLFuncMap()).
 			ParseFS(templatesFS, "templates/*.tmpl"),
 	)
 
+	for tmplName, overridePath := range g.opt.TemplateOverrides {
+		content, err := os.ReadFile(overridePath)
+		if err != nil {
+			return xerrors.Errorf("failed to read template override for %s: %w", tmplName, err)
+		}
+
+		if _, err := templates.New(tmplName).Parse(string(content)); err != nil {
+			return xerrors.Errorf("failed to parse template override for %s: %w", tmplName, err)
+		}
+	}
+
 	gcgen := gocodegen.NewGoCodeGenerator(templates)
 
 	targets := []struct {
@@ -398,6 +425,26 @@ func (g *structGenerator) generate() error {
 		{"unique.go.tmpl", "unique_gen.go"},
 	}
 
+	if g.param.EnableBatch {
+		targets = append(targets, struct {
+			tmplName      string
+			generatedName string
+		}{"batch.go.tmpl", "batch_gen.go"})
+	}
+
+	if g.param.GraphQLEnabled {
+		targets = append(targets,
+			struct {
+				tmplName      string
+				generatedName string
+			}{"graphql.tmpl", g.param.FileName + ".graphql"},
+			struct {
+				tmplName      string
+				generatedName string
+			}{"graphql_resolver.go.tmpl", g.param.FileName + "_resolver_gen.go"},
+		)
+	}
+
 	for _, t := range targets {
 		if err := gcgen.GenerateTo(t.tmplName, g.param, filepath.Join(g.opt.OutputDir, t.generatedName)); err != nil {
 			return xerrors.Errorf("failed to generate %s: %w", t.generatedName, err)
@@ -0,0 +1,64 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-generalize/go-easyparser"
+)
+
+// TestBatchGeneration_RendersUniqueRollbackAndVersionCheck drives generation
+// for the BatchEnabled fixture and checks that the emitted batch_gen.go
+// source contains the unique-index reservation/release calls and the
+// transaction-scoped Version check described in the request.
+//
+// This only asserts the generated *code* calls the right helpers; it does
+// not drive an actual transaction abort against Firestore (no emulator is
+// available in this environment), so the rollback and version-check
+// behavior themselves remain unverified end-to-end.
+func TestBatchGeneration_RendersUniqueRollbackAndVersionCheck(t *testing.T) {
+	pkg, err := easyparser.ParseDir("./testfiles/auto")
+	if err != nil {
+		t.Fatalf("failed to parse testfiles/auto: %+v", err)
+	}
+
+	obj, ok := pkg.Structs["BatchEnabled"]
+	if !ok {
+		t.Fatal("BatchEnabled struct not found in testfiles/auto")
+	}
+
+	outDir := t.TempDir()
+
+	g, err := newStructGenerator(obj, "BatchEnabled", "test", GenerateOption{
+		OutputDir:   outDir,
+		EnableBatch: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to initialize generator: %+v", err)
+	}
+
+	if err := g.parseType(); err != nil {
+		t.Fatalf("failed to parse type: %+v", err)
+	}
+
+	if err := g.generate(); err != nil {
+		t.Fatalf("failed to generate: %+v", err)
+	}
+
+	content, err := os.ReadFile(filepath.Join(outDir, "batch_gen.go"))
+	if err != nil {
+		t.Fatalf("failed to read batch_gen.go: %+v", err)
+	}
+
+	for _, want := range []string{
+		"reserveUniqueFieldsBatchEnabled",
+		"releaseUniqueFieldsBatchEnabled",
+		"current.Version + 1",
+	} {
+		if !strings.Contains(string(content), want) {
+			t.Errorf("batch_gen.go does not contain %q", want)
+		}
+	}
+}
@@ -0,0 +1,19 @@
+package model
+
+import "time"
+
+//go:generate volcago BatchEnabled
+
+// BatchEnabled バッチ/トランザクション生成を有効化したモデル。batch生成はCLIフラグではなく
+// GenerateOption.EnableBatch (or a volcago.yaml target's enableBatch) 経由で有効化する。
+type BatchEnabled struct {
+	ID        string     `firestore:"-" firestore_key:"auto"`
+	Text      string     `firestore:"text" unique:"true"`
+	CreatedAt time.Time  `firestore:"createdAt"`
+	CreatedBy string     `firestore:"createdBy"`
+	UpdatedAt time.Time  `firestore:"updatedAt"`
+	UpdatedBy string     `firestore:"updatedBy"`
+	DeletedAt *time.Time `firestore:"deletedAt"`
+	DeletedBy string     `firestore:"deletedBy"`
+	Version   int        `firestore:"version"`
+}
@@ -0,0 +1,10 @@
+package model
+
+//go:generate volcago Exposed
+
+// Exposed GraphQLスキーマ/リゾルバ生成を有効化したモデル
+type Exposed struct {
+	ID    string `firestore:"-" firestore_key:"auto" gqlgen:"expose"`
+	Title string `firestore:"title"`
+	Count int    `firestore:"count"`
+}
@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+//go:generate volcago ExposedNested
+
+// Address is a nested struct embedded as a value in ExposedNested, exercising
+// the dotted FieldInfo.Field path (e.g. "Address.City") that gqlFieldName
+// must turn into a valid GraphQL identifier.
+type Address struct {
+	City string `firestore:"city"`
+}
+
+// ExposedNested covers the nested-struct, slice and meta-Time branches of
+// gqlType/gqlFieldName that the flat Exposed fixture doesn't.
+type ExposedNested struct {
+	ID        string    `firestore:"-" firestore_key:"auto" gqlgen:"expose"`
+	Address   Address   `firestore:"address"`
+	Tags      []string  `firestore:"tags"`
+	CreatedAt time.Time `firestore:"createdAt"`
+}
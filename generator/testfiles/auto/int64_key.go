@@ -0,0 +1,9 @@
+package model
+
+//go:generate volcago Int64Key
+
+// Int64Key キーがint64
+type Int64Key struct {
+	ID   int64  `firestore:"-" firestore_key:""`
+	Text string `firestore:"text"`
+}
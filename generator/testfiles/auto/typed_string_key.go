@@ -0,0 +1,13 @@
+package model
+
+//go:generate volcago TypedStringKey
+
+// TypedStringKeyID is a named string type used as a document key,
+// e.g. for a typed user ID.
+type TypedStringKeyID string
+
+// TypedStringKey キーが独自定義のstring型
+type TypedStringKey struct {
+	ID   TypedStringKeyID `firestore:"-" firestore_key:"auto"`
+	Text string           `firestore:"text"`
+}